@@ -0,0 +1,77 @@
+package ids
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/gotruward/ids/gen"
+)
+
+// Alphabet defines the 32 symbols used to represent 5-bit groups as
+// characters, plus the reverse lookup needed to turn a character back into
+// its symbol index. Lookups fold case by default; a codec built with
+// WithCaseSensitive() additionally requires a character's case to match the
+// alphabet's own.
+type Alphabet struct {
+	chars [32]byte
+	index [256]int16
+}
+
+// NewAlphabet builds an Alphabet out of 32 distinct characters, index 0
+// first. It panics if chars contains a duplicate, case-insensitively, since
+// that would make some symbols undecodable.
+func NewAlphabet(chars [32]byte) Alphabet {
+	a := Alphabet{chars: chars}
+	for i := range a.index {
+		a.index[i] = -1
+	}
+
+	for i, ch := range chars {
+		lower := byte(unicode.ToLower(rune(ch)))
+		upper := byte(unicode.ToUpper(rune(ch)))
+
+		if a.index[lower] >= 0 {
+			panic(fmt.Sprintf("ids: duplicate alphabet char %c", ch))
+		}
+
+		a.index[lower] = int16(i)
+		a.index[upper] = int16(i)
+	}
+
+	return a
+}
+
+// charAt returns the character for a given 5-bit symbol index
+func (a Alphabet) charAt(index uint8) byte {
+	return a.chars[index]
+}
+
+// charIndex returns the symbol index for a given character. When
+// caseSensitive is true, the character must match the alphabet's own case
+// exactly; otherwise either case of a letter resolves to the same index.
+func (a Alphabet) charIndex(ch byte, caseSensitive bool) (uint8, bool) {
+	idx := a.index[ch]
+	if idx < 0 {
+		return 0, false
+	}
+
+	if caseSensitive && a.chars[idx] != ch {
+		return 0, false
+	}
+
+	return uint8(idx), true
+}
+
+var (
+	// CrockfordAlphabet is the Crockford base32 alphabet (digits 0-9 and
+	// lowercase letters a-z, minus i, l, o, u) and is this package's default
+	CrockfordAlphabet = NewAlphabet(gen.CrockfordChars)
+
+	// RFC4648Alphabet is the standard base32 alphabet (A-Z2-7) used by
+	// encoding/base32.StdEncoding
+	RFC4648Alphabet = NewAlphabet(gen.RFC4648Chars)
+
+	// RFC4648HexAlphabet is the extended hex base32 alphabet (0-9A-V) used
+	// by encoding/base32.HexEncoding
+	RFC4648HexAlphabet = NewAlphabet(gen.RFC4648HexChars)
+)
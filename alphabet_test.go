@@ -0,0 +1,95 @@
+package ids_test
+
+import (
+	"testing"
+
+	"github.com/gotruward/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlphabetOptions(t *testing.T) {
+
+	t.Run("NewCodec with no options behaves like NewCodecForNames", func(t *testing.T) {
+		idgen := ids.NewCodec()
+		id, err := idgen.Encode([]byte{1})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "10", id)
+		}
+	})
+
+	t.Run("RFC4648Alphabet round-trips and differs from Crockford", func(t *testing.T) {
+		idgen := ids.NewCodec(ids.WithNames("rfc"), ids.WithAlphabet(ids.RFC4648Alphabet))
+
+		value := []byte{1, 2, 3, 4, 5}
+		id, err := idgen.Encode(value)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		decoded, err := idgen.Decode(id)
+		if assert.NoError(t, err) {
+			assert.Equal(t, value, decoded)
+		}
+
+		crockfordID, err := ids.NewCodecForNames("rfc").Encode(value)
+		if assert.NoError(t, err) {
+			assert.NotEqual(t, crockfordID, id)
+		}
+	})
+
+	t.Run("RFC4648HexAlphabet round-trips", func(t *testing.T) {
+		idgen := ids.NewCodec(ids.WithAlphabet(ids.RFC4648HexAlphabet))
+
+		value := []byte{255, 0, 128}
+		id, err := idgen.Encode(value)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		decoded, err := idgen.Decode(id)
+		if assert.NoError(t, err) {
+			assert.Equal(t, value, decoded)
+		}
+	})
+
+	t.Run("NewAlphabet panics on a duplicate char", func(t *testing.T) {
+		chars := [32]byte{
+			'a', 'a', '2', '3', '4', '5', '6', '7',
+			'8', '9', 'A', 'B', 'C', 'D', 'E', 'F',
+			'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N',
+			'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V',
+		}
+
+		assert.Panics(t, func() {
+			ids.NewAlphabet(chars)
+		})
+	})
+
+	t.Run("WithCaseSensitive rejects wrong-case IDs that folding would accept", func(t *testing.T) {
+		idgen := ids.NewCodec(ids.WithNames("Users"), ids.WithAlphabet(ids.RFC4648Alphabet), ids.WithCaseSensitive())
+
+		id, err := idgen.Encode([]byte{1, 2, 3})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// sanity: the ID as produced decodes fine
+		_, err = idgen.Decode(id)
+		assert.NoError(t, err)
+
+		// flipping the case of the body should now be rejected
+		flipped := id[:len(id)-1] + string(flipCase(id[len(id)-1]))
+		_, err = idgen.Decode(flipped)
+		assert.Error(t, err)
+	})
+}
+
+func flipCase(ch byte) byte {
+	if ch >= 'a' && ch <= 'z' {
+		return ch - ('a' - 'A')
+	}
+	if ch >= 'A' && ch <= 'Z' {
+		return ch + ('a' - 'A')
+	}
+	return ch
+}
@@ -0,0 +1,87 @@
+package ids
+
+import (
+	"errors"
+	"unicode"
+
+	"github.com/gotruward/ids/gen"
+)
+
+// checkModulus is the modulus used by the Crockford check symbol, as defined
+// by the Crockford base32 specification.
+const checkModulus = 37
+
+// ErrCheckFailed happens when the trailing check symbol of a semantic ID
+// doesn't match the value it is supposed to protect
+var ErrCheckFailed = errors.New("semantic ID check symbol mismatch")
+
+// NewCodecForNamesWithCheck creates an IDCodec for a given sequence of names
+// that additionally appends a single Crockford-style check symbol to every
+// encoded ID, and validates it on Decode. This gives cheap typo detection for
+// user-visible IDs at the cost of one extra character.
+func NewCodecForNamesWithCheck(names ...string) IDCodec {
+	return &checkedIDCodec{IDCodec: NewCodecForNames(names...)}
+}
+
+//
+// Implementation
+//
+
+type checkedIDCodec struct {
+	IDCodec
+}
+
+// unwrap exposes the codec checkedIDCodec wraps, so callers that need to
+// reach the underlying *prefixedIDCodec (e.g. the streaming encoder/decoder)
+// can see through the wrapper.
+func (c *checkedIDCodec) unwrap() IDCodec {
+	return c.IDCodec
+}
+
+func (c *checkedIDCodec) Encode(value []byte) (string, error) {
+	id, err := c.IDCodec.Encode(value)
+	if err != nil {
+		return "", err
+	}
+
+	return id + string(checkChar(value)), nil
+}
+
+func (c *checkedIDCodec) Decode(id string) ([]byte, error) {
+	if len(id) == 0 {
+		return nil, ErrMalformedID
+	}
+
+	bodyID := id[:len(id)-1]
+	givenCheckChar := byte(unicode.ToLower(rune(id[len(id)-1])))
+
+	value, err := c.IDCodec.Decode(bodyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if checkChar(value) != givenCheckChar {
+		return nil, ErrCheckFailed
+	}
+
+	return value, nil
+}
+
+func (c *checkedIDCodec) CanDecode(id string) bool {
+	if len(id) == 0 {
+		return false
+	}
+
+	return c.IDCodec.CanDecode(id[:len(id)-1])
+}
+
+// checkChar computes the Crockford check symbol for value, treating it as a
+// big-endian unsigned integer.
+func checkChar(value []byte) byte {
+	mod := uint32(0)
+	for _, b := range value {
+		mod = (mod*256 + uint32(b)) % checkModulus
+	}
+
+	return gen.CrockfordCheckChars[mod]
+}
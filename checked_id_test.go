@@ -0,0 +1,76 @@
+package ids_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gotruward/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckedSemanticID(t *testing.T) {
+
+	t.Run("round-trips with a valid check symbol", func(t *testing.T) {
+		idgen := ids.NewCodecForNamesWithCheck("users")
+
+		for i := 1; i <= 32; i++ {
+			value := make([]byte, i)
+			rand.Read(value)
+
+			id, err := idgen.Encode(value)
+			if !assert.NoError(t, err, "encoding failed for size=%d", i) {
+				continue
+			}
+
+			decoded, err := idgen.Decode(id)
+			if assert.NoError(t, err, "decoding failed for id=%s", id) {
+				assert.Equal(t, value, decoded, "decoded value mismatch for id=%s", id)
+			}
+		}
+	})
+
+	t.Run("rejects a tampered check symbol", func(t *testing.T) {
+		idgen := ids.NewCodecForNamesWithCheck("users")
+
+		id, err := idgen.Encode([]byte{1, 2, 3})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		tampered := id[:len(id)-1] + otherCheckChar(id[len(id)-1])
+		_, err = idgen.Decode(tampered)
+		assert.Equal(t, ids.ErrCheckFailed, err)
+	})
+
+	t.Run("check symbol is case-insensitive", func(t *testing.T) {
+		idgen := ids.NewCodecForNamesWithCheck()
+
+		// 36 mod 37 = 36, whose check symbol is the letter 'u'
+		id, err := idgen.Encode([]byte{36})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		upper := id[:len(id)-1] + string(upperByte(id[len(id)-1]))
+		decoded, err := idgen.Decode(upper)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []byte{36}, decoded)
+		}
+	})
+}
+
+func otherCheckChar(ch byte) string {
+	for _, candidate := range []byte{'0', '1', '*', '~', '$', '=', 'u'} {
+		if candidate != ch {
+			return string(candidate)
+		}
+	}
+	return "9"
+}
+
+func upperByte(ch byte) byte {
+	if ch >= 'a' && ch <= 'z' {
+		return ch - ('a' - 'A')
+	}
+	return ch
+}
@@ -0,0 +1,9 @@
+// Package gen holds the generated base32 alphabet tables consumed by the
+// ids package. Files in this package are produced by base32_table_gen.go
+// and shouldn't be edited by hand; run `go generate` after changing the
+// directives below to regenerate them, or to add a new alphabet.
+package gen
+
+//go:generate go run ../tools/base32_table_gen.go -name Crockford -chars 0123456789abcdefghjkmnpqrstvwxyz -check -out crockford.go
+//go:generate go run ../tools/base32_table_gen.go -name RFC4648 -chars ABCDEFGHIJKLMNOPQRSTUVWXYZ234567 -out rfc4648.go
+//go:generate go run ../tools/base32_table_gen.go -name RFC4648Hex -chars 0123456789ABCDEFGHIJKLMNOPQRSTUV -out rfc4648hex.go
@@ -0,0 +1,58 @@
+package ids
+
+import "strings"
+
+// Option configures an IDCodec built by NewCodec
+type Option func(*codecConfig)
+
+type codecConfig struct {
+	names         []string
+	alphabet      Alphabet
+	caseSensitive bool
+}
+
+// WithNames sets the prefix names of the codec being built, see
+// NewCodecForNames
+func WithNames(names ...string) Option {
+	return func(cfg *codecConfig) {
+		cfg.names = names
+	}
+}
+
+// WithAlphabet sets the base32 alphabet of the codec being built, see
+// CrockfordAlphabet, RFC4648Alphabet and RFC4648HexAlphabet
+func WithAlphabet(alphabet Alphabet) Option {
+	return func(cfg *codecConfig) {
+		cfg.alphabet = alphabet
+	}
+}
+
+// WithCaseSensitive makes the codec being built preserve the alphabet's own
+// case: Decode rejects an otherwise valid ID whose case doesn't match what
+// Encode would have produced, instead of folding case
+func WithCaseSensitive() Option {
+	return func(cfg *codecConfig) {
+		cfg.caseSensitive = true
+	}
+}
+
+// NewCodec creates an IDCodec out of the given options. With no options, it
+// behaves like NewCodecForNames(): no prefix, CrockfordAlphabet, folding
+// case.
+func NewCodec(opts ...Option) IDCodec {
+	cfg := codecConfig{alphabet: CrockfordAlphabet}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	names := cfg.names
+	if !cfg.caseSensitive {
+		lowercasedNames := make([]string, len(names))
+		for i, name := range names {
+			lowercasedNames[i] = strings.ToLower(name)
+		}
+		names = lowercasedNames
+	}
+
+	return &prefixedIDCodec{Names: names, Alphabet: cfg.alphabet, CaseSensitive: cfg.caseSensitive}
+}
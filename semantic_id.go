@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"strings"
 	"unicode"
-
-	"github.com/gotruward/ids/gen"
 )
 
 // MaxBytesIDSize defines upper bound limit for number of bytes in a given semantic ID
@@ -48,14 +46,10 @@ type IDCodec interface {
 	GetPrefix() string
 }
 
-// NewCodecForNames creates IDCodec for a given sequence of names
+// NewCodecForNames creates IDCodec for a given sequence of names, using the
+// default CrockfordAlphabet and folding case; see NewCodec for more control
 func NewCodecForNames(names ...string) IDCodec {
-	lowercasedNames := make([]string, len(names))
-	for index, name := range names {
-		lowercasedNames[index] = strings.ToLower(name)
-	}
-
-	return &prefixedIDCodec{Names: lowercasedNames}
+	return NewCodec(WithNames(names...))
 }
 
 //
@@ -66,7 +60,9 @@ const prefixSeparator byte = '-'
 
 type prefixedIDCodec struct {
 	IDCodec
-	Names []string
+	Names         []string
+	Alphabet      Alphabet
+	CaseSensitive bool
 }
 
 func newBufferWithPrefix(names []string, capacity int) *bytes.Buffer {
@@ -104,7 +100,7 @@ func (c *prefixedIDCodec) Encode(value []byte) (string, error) {
 
 	capacity := getPrefixLength(c.Names) + int(getEncodedSize(uint(valueLen)))
 	buf := newBufferWithPrefix(c.Names, capacity)
-	appendBytes(value, buf)
+	appendBytes(c.Alphabet, value, buf)
 	if buf.Len() != capacity {
 		return "", fmt.Errorf("internal: unexpected buffer size") // shouldn't happen
 	}
@@ -117,7 +113,7 @@ func (c *prefixedIDCodec) Decode(id string) ([]byte, error) {
 		return nil, err
 	}
 
-	return decodeBytes(id, prefixLength, len(id))
+	return decodeBytes(c.Alphabet, c.CaseSensitive, id, prefixLength, len(id))
 }
 
 //
@@ -142,9 +138,13 @@ func computeAndValidatePrefix(c *prefixedIDCodec, id string) (int, error) {
 			// check, that current prefix part matches corresponding SemanticID region
 			nameChar := name[nameCharIndex]
 			if charIndex < idLen {
-				ch := byte(unicode.ToLower(rune(id[charIndex])))
+				ch := id[charIndex]
 				charIndex++
 
+				if !c.CaseSensitive {
+					ch = byte(unicode.ToLower(rune(ch)))
+				}
+
 				if ch == nameChar {
 					continue
 				}
@@ -170,7 +170,7 @@ func computeAndValidatePrefix(c *prefixedIDCodec, id string) (int, error) {
 	if (idLen - charIndex) > 0 {
 		// validate SemanticID body
 		for i := uint(charIndex); i < uint(idLen); i++ {
-			_, err := getBaseCharCode(id, i)
+			_, err := getBaseCharCode(c.Alphabet, c.CaseSensitive, id, i)
 			if err != nil {
 				return 0, err
 			}
@@ -192,28 +192,20 @@ const baseBits uint = 5
 const base uint = 1 << baseBits
 const baseMask = uint8(base - 1)
 
-func getBaseChar(index uint8) uint8 {
-	return gen.Chars[index]
-}
-
-func getBaseCharCode(value string, charPos uint) (uint8, error) {
-	ch := int(value[int(charPos)])
-
-	if ch < len(gen.CharToIndex) {
-		index := gen.CharToIndex[ch]
-		if index >= 0 {
-			return uint8(index), nil
-		}
+func getBaseCharCode(alphabet Alphabet, caseSensitive bool, value string, charPos uint) (uint8, error) {
+	index, ok := alphabet.charIndex(value[charPos], caseSensitive)
+	if !ok {
+		return 0, ErrInvalidChar
 	}
 
-	return uint8(0), ErrInvalidChar
+	return index, nil
 }
 
 func getEncodedSize(size uint) uint {
 	return (size*byteSize + baseBits - 1) / baseBits
 }
 
-func appendBytes(body []byte, buf *bytes.Buffer) {
+func appendBytes(alphabet Alphabet, body []byte, buf *bytes.Buffer) {
 	bodyLen := uint(len(body))
 	bodyBits := byteSize * bodyLen
 	fullBase32ElemCount := bodyBits / baseBits
@@ -234,17 +226,17 @@ func appendBytes(body []byte, buf *bytes.Buffer) {
 			offsetBitPos = endBitPos
 		}
 
-		buf.WriteByte(getBaseChar(base32ElemIndex))
+		buf.WriteByte(alphabet.charAt(base32ElemIndex))
 	}
 
 	if partialBase32ElemBits > 0 {
 		lastElem := body[bodyLen-1]
 		base32ElemIndex := lastElem >> (byteSize - partialBase32ElemBits)
-		buf.WriteByte(getBaseChar(base32ElemIndex))
+		buf.WriteByte(alphabet.charAt(base32ElemIndex))
 	}
 }
 
-func decodeBytes(value string, startPos int, endPos int) ([]byte, error) {
+func decodeBytes(alphabet Alphabet, caseSensitive bool, value string, startPos int, endPos int) ([]byte, error) {
 	if startPos < 0 {
 		// shouldn't happen
 		return nil, fmt.Errorf("internal: negative startPos=%d", startPos)
@@ -269,7 +261,7 @@ func decodeBytes(value string, startPos int, endPos int) ([]byte, error) {
 			continue
 		}
 
-		base32Digit, err := getBaseCharCode(value, charPos)
+		base32Digit, err := getBaseCharCode(alphabet, caseSensitive, value, charPos)
 		if err != nil {
 			return nil, err
 		}
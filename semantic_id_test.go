@@ -8,8 +8,10 @@ import (
 	"unicode"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/google/uuid"
 	"github.com/gotruward/ids"
 	"github.com/gotruward/ids/protoids"
+	"github.com/gotruward/ids/uuids"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -214,6 +216,53 @@ func TestEncodingProtoID(t *testing.T) {
 	})
 }
 
+func TestEncodingUUID(t *testing.T) {
+
+	t.Run("encoding and decoding a UUID", func(t *testing.T) {
+		// Given:
+		u := uuid.New()
+		idgen := ids.NewCodecForNames("user")
+
+		// When:
+		id, err := uuids.Encode(idgen, u)
+
+		// Then:
+		if !assert.NoError(t, err, "unable to encode UUID") {
+			return
+		}
+
+		restoredUUID, err := uuids.Decode(idgen, id)
+		if !assert.NoError(t, err, "unable to decode UUID for id=%s", id) {
+			return
+		}
+		assert.Equal(t, u, restoredUUID, "UUID mismatch for id=%s", id)
+	})
+
+	t.Run("decoding a non-UUID semantic ID fails", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("user")
+
+		id, err := idgen.Encode([]byte{1, 2, 3})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, err = uuids.Decode(idgen, id)
+		assert.Equal(t, uuids.ErrNotUUID, err)
+	})
+
+	t.Run("minting a UUIDv4 semantic ID", func(t *testing.T) {
+		codec := uuids.NewV4Codec("user")
+
+		id, err := codec.Mint()
+		if !assert.NoError(t, err, "unable to mint UUID") {
+			return
+		}
+
+		_, err = uuids.Decode(codec, id)
+		assert.NoError(t, err, "minted ID should decode back to a UUID")
+	})
+}
+
 //
 // Helpers
 //
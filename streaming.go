@@ -0,0 +1,229 @@
+package ids
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrEncoderClosed happens when Write is called on an Encoder after Close
+var ErrEncoderClosed = errors.New("ids: write to closed encoder")
+
+// streamBlockSize is the number of raw bytes (40 bits) that map onto a whole
+// number of base32 characters (8), so blocks can be encoded independently
+// without carrying partial bits between them.
+const streamBlockSize = 5
+
+// streamCharBlockSize is the base32 character counterpart of streamBlockSize.
+const streamCharBlockSize = streamBlockSize * int(byteSize) / int(baseBits)
+
+// NewEncoder returns an io.WriteCloser that writes the prefix of codec once,
+// then streams the base32 encoding of the bytes written to it. Unlike
+// IDCodec.Encode, it is not bound by MaxBytesIDSize since it never holds the
+// whole payload in memory. Close must be called to flush the trailing
+// partial base32 group, if any.
+func NewEncoder(codec IDCodec, w io.Writer) io.WriteCloser {
+	alphabet, _ := codecAlphabet(codec)
+	return &encoder{codec: codec, w: w, alphabet: alphabet}
+}
+
+type encoder struct {
+	codec      IDCodec
+	w          io.Writer
+	alphabet   Alphabet
+	prefixDone bool
+	pending    []byte
+	closed     bool
+}
+
+// unwrappableCodec is implemented by IDCodec wrappers (checkedIDCodec,
+// varintIDCodec) that embed another IDCodec, so codecAlphabet can see
+// through them down to the underlying *prefixedIDCodec.
+type unwrappableCodec interface {
+	unwrap() IDCodec
+}
+
+// codecAlphabet returns the Alphabet and case-sensitivity backing codec,
+// unwrapping it down to its underlying *prefixedIDCodec (as produced by
+// NewCodec and its convenience wrappers) if it's a wrapper, and falling
+// back to CrockfordAlphabet/case-folding otherwise.
+func codecAlphabet(codec IDCodec) (Alphabet, bool) {
+	for {
+		if c, ok := codec.(*prefixedIDCodec); ok {
+			return c.Alphabet, c.CaseSensitive
+		}
+
+		u, ok := codec.(unwrappableCodec)
+		if !ok {
+			return CrockfordAlphabet, false
+		}
+		codec = u.unwrap()
+	}
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, ErrEncoderClosed
+	}
+
+	if err := e.writePrefixOnce(); err != nil {
+		return 0, err
+	}
+
+	e.pending = append(e.pending, p...)
+
+	for len(e.pending) >= streamBlockSize {
+		if err := e.flushBlock(e.pending[:streamBlockSize]); err != nil {
+			return 0, err
+		}
+		e.pending = e.pending[streamBlockSize:]
+	}
+
+	return len(p), nil
+}
+
+func (e *encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+
+	if err := e.writePrefixOnce(); err != nil {
+		return err
+	}
+
+	if len(e.pending) == 0 {
+		return nil
+	}
+
+	err := e.flushBlock(e.pending)
+	e.pending = nil
+	return err
+}
+
+func (e *encoder) writePrefixOnce() error {
+	if e.prefixDone {
+		return nil
+	}
+	e.prefixDone = true
+
+	_, err := io.WriteString(e.w, e.codec.GetPrefix())
+	return err
+}
+
+func (e *encoder) flushBlock(block []byte) error {
+	buf := &bytes.Buffer{}
+	buf.Grow(int(getEncodedSize(uint(len(block)))))
+	appendBytes(e.alphabet, block, buf)
+	_, err := e.w.Write(buf.Bytes())
+	return err
+}
+
+// NewDecoder returns an io.Reader that validates and strips the prefix of
+// codec from the head of r, then streams the decoded bytes of the remaining
+// base32 body as complete groups of streamCharBlockSize characters arrive.
+func NewDecoder(codec IDCodec, r io.Reader) io.Reader {
+	alphabet, caseSensitive := codecAlphabet(codec)
+	return &decoder{codec: codec, r: r, alphabet: alphabet, caseSensitive: caseSensitive}
+}
+
+type decoder struct {
+	codec         IDCodec
+	r             io.Reader
+	alphabet      Alphabet
+	caseSensitive bool
+	prefixChecked bool
+	pendingChars  []byte
+	pendingBytes  []byte
+	err           error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	if n := d.drainPendingBytes(p); n > 0 {
+		return n, nil
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+
+	if !d.prefixChecked {
+		if err := d.checkPrefix(); err != nil {
+			d.err = err
+			return 0, err
+		}
+		d.prefixChecked = true
+	}
+
+	chunk := make([]byte, len(p))
+	n, readErr := d.r.Read(chunk)
+	if n > 0 {
+		d.pendingChars = append(d.pendingChars, chunk[:n]...)
+	}
+
+	for len(d.pendingChars) >= streamCharBlockSize {
+		decoded, err := decodeBytes(d.alphabet, d.caseSensitive, string(d.pendingChars[:streamCharBlockSize]), 0, streamCharBlockSize)
+		if err != nil {
+			d.err = err
+			return 0, err
+		}
+		d.pendingBytes = append(d.pendingBytes, decoded...)
+		d.pendingChars = d.pendingChars[streamCharBlockSize:]
+	}
+
+	if readErr == io.EOF {
+		if len(d.pendingChars) > 0 {
+			decoded, err := decodeBytes(d.alphabet, d.caseSensitive, string(d.pendingChars), 0, len(d.pendingChars))
+			if err != nil {
+				d.err = err
+				return 0, err
+			}
+			d.pendingBytes = append(d.pendingBytes, decoded...)
+			d.pendingChars = nil
+		}
+	} else if readErr != nil {
+		d.err = readErr
+	}
+
+	if n := d.drainPendingBytes(p); n > 0 {
+		return n, nil
+	}
+
+	if d.err == nil && readErr == io.EOF {
+		d.err = io.EOF
+	}
+	return 0, d.err
+}
+
+func (d *decoder) drainPendingBytes(p []byte) int {
+	if len(d.pendingBytes) == 0 {
+		return 0
+	}
+	n := copy(p, d.pendingBytes)
+	d.pendingBytes = d.pendingBytes[n:]
+	return n
+}
+
+func (d *decoder) checkPrefix() error {
+	prefix := d.codec.GetPrefix()
+	if len(prefix) == 0 {
+		return nil
+	}
+
+	buf := make([]byte, len(prefix))
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ErrMalformedID
+		}
+		return err
+	}
+
+	if d.caseSensitive {
+		if string(buf) != prefix {
+			return ErrMalformedID
+		}
+	} else if !strings.EqualFold(string(buf), prefix) {
+		return ErrMalformedID
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package ids
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCodecAlphabetUnwrapsWrappers is a white-box test: it builds wrapped
+// codecs around a non-default alphabet directly (there is no exported way
+// to do so) to prove codecAlphabet sees through checkedIDCodec and
+// varintIDCodec instead of falling back to CrockfordAlphabet.
+func TestCodecAlphabetUnwrapsWrappers(t *testing.T) {
+	inner := NewCodec(WithNames("x"), WithAlphabet(RFC4648Alphabet))
+	payload := []byte{1, 2, 3, 4, 5}
+
+	t.Run("checkedIDCodec", func(t *testing.T) {
+		codec := &checkedIDCodec{IDCodec: inner}
+
+		expected, err := codec.Encode(payload)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var buf bytes.Buffer
+		enc := NewEncoder(codec, &buf)
+		if _, err := enc.Write(payload); !assert.NoError(t, err) {
+			return
+		}
+		assert.NoError(t, enc.Close())
+
+		// the streamed body matches Encode's body; the trailing check char is
+		// appended by checkedIDCodec.Encode itself and isn't part of the
+		// streamed byte payload, so compare everything but it.
+		assert.Equal(t, expected[:len(expected)-1], buf.String())
+
+		dec := NewDecoder(codec, bytes.NewBufferString(buf.String()))
+		actual, err := ioutil.ReadAll(dec)
+		if assert.NoError(t, err) {
+			assert.Equal(t, payload, actual)
+		}
+	})
+
+	t.Run("varintIDCodec", func(t *testing.T) {
+		codec := &varintIDCodec{IDCodec: inner}
+
+		id, err := codec.Encode(payload)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		dec := NewDecoder(codec, bytes.NewBufferString(id))
+		actual, err := ioutil.ReadAll(dec)
+		if assert.NoError(t, err) {
+			assert.Equal(t, payload, actual)
+		}
+	})
+}
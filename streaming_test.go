@@ -0,0 +1,111 @@
+package ids_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/gotruward/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreaming(t *testing.T) {
+
+	t.Run("streamed encoding matches Encode for various payload sizes", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("stream")
+
+		for size := 1; size <= 64; size++ {
+			payload := make([]byte, size)
+			rand.Read(payload)
+
+			expected, err := idgen.Encode(payload)
+			if !assert.NoError(t, err, "Encode failed for size=%d", size) {
+				continue
+			}
+
+			var buf bytes.Buffer
+			enc := ids.NewEncoder(idgen, &buf)
+			_, err = enc.Write(payload[:size/2])
+			assert.NoError(t, err)
+			_, err = enc.Write(payload[size/2:])
+			assert.NoError(t, err)
+			assert.NoError(t, enc.Close())
+
+			assert.Equal(t, expected, buf.String(), "streamed encoding mismatch for size=%d", size)
+		}
+	})
+
+	t.Run("streamed decoding matches Decode for various payload sizes", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("a", "bb")
+
+		for size := 1; size <= 64; size++ {
+			payload := make([]byte, size)
+			rand.Read(payload)
+
+			id, err := idgen.Encode(payload)
+			if !assert.NoError(t, err, "Encode failed for size=%d", size) {
+				continue
+			}
+
+			dec := ids.NewDecoder(idgen, bytes.NewBufferString(id))
+			actual, err := ioutil.ReadAll(dec)
+			if !assert.NoError(t, err, "streamed decoding failed for size=%d", size) {
+				continue
+			}
+
+			assert.Equal(t, payload, actual, "streamed decoding mismatch for size=%d", size)
+		}
+	})
+
+	t.Run("decoder rejects malformed prefix", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("users")
+
+		dec := ids.NewDecoder(idgen, bytes.NewBufferString("wrong-00"))
+		_, err := ioutil.ReadAll(dec)
+		assert.Equal(t, ids.ErrMalformedID, err)
+	})
+
+	t.Run("encoder rejects writes after close", func(t *testing.T) {
+		idgen := ids.NewCodecForNames()
+
+		var buf bytes.Buffer
+		enc := ids.NewEncoder(idgen, &buf)
+		assert.NoError(t, enc.Close())
+
+		_, err := enc.Write([]byte{1})
+		assert.Equal(t, ids.ErrEncoderClosed, err)
+	})
+
+	t.Run("streamed decoding matches Decode for a varint codec", func(t *testing.T) {
+		idgen := ids.NewVarintCodecForNames("v")
+		payload := []byte{0, 6, 2, 8}
+
+		id, err := idgen.Encode(payload)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		dec := ids.NewDecoder(idgen, bytes.NewBufferString(id))
+		actual, err := ioutil.ReadAll(dec)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, []byte{6, 2, 8}, actual)
+	})
+
+	t.Run("empty stream round-trips to empty payload", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("empty")
+
+		var buf bytes.Buffer
+		enc := ids.NewEncoder(idgen, &buf)
+		assert.NoError(t, enc.Close())
+		assert.Equal(t, idgen.GetPrefix(), buf.String())
+
+		dec := ids.NewDecoder(idgen, &buf)
+		actual, err := ioutil.ReadAll(dec)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(actual))
+	})
+}
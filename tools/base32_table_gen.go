@@ -1,81 +1,89 @@
-// Tool, that generates base32 tables:
-// 		one, that maps base32 index to the corresponding character
-// 		and the other, that maps character (byte) to the corresponding base32 index
-// Since this is only a tool, it should be ignored in the build process
+// Tool, that generates a base32 alphabet table: a [32]byte array mapping
+// base32 index to the corresponding character, named after the -name flag.
+// Pass -check to additionally emit a CheckChars table for the Crockford
+// check symbol scheme. The result is written to the file given by -out.
+// Since this is only a tool, it should be ignored in the build process.
+//
+// Example:
+//	go run base32_table_gen.go -name Crockford -chars 0123456789abcdefghjkmnpqrstvwxyz -check -out crockford.go
 
 // +build ignore
 
 package main
 
 import (
-	"fmt"
 	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
 )
 
-var chars = [...]byte{
-	'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
-	'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'j', 'k',
-	'm', 'n', 'p', 'q', 'r', 's', 't', 'v', 'w', 'x',
-	'y', 'z',
-}
-
+// checkExtraChars are the five symbols Crockford base32 appends to the 32
+// data symbols to represent check values 32..36.
+var checkExtraChars = [...]byte{'*', '~', '$', '=', 'u'}
 
 func main() {
-	// sanity check
-	if len(chars) != 32 {
-		panic("Length of base32 chars is too big")
+	name := flag.String("name", "", "name of the alphabet, used as a prefix for the generated variable, e.g. Crockford")
+	charsFlag := flag.String("chars", "", "the 32 characters of the alphabet, index 0 first")
+	withCheck := flag.Bool("check", false, "also emit a <name>CheckChars table for the Crockford check symbol scheme")
+	out := flag.String("out", "", "file to write the generated Go source to")
+	flag.Parse()
+
+	if *name == "" {
+		panic("-name is required")
 	}
 
-	// include standard code generator warning and package
-	fmt.Println("// Code generated by base32_table_gen. DO NOT EDIT.")
-	fmt.Println("package gen")
-	fmt.Println()
-
-	maxChar := byte(0);
-	reverseCharIndices := make(map[byte]int)
-
-	// generate base32 index to chars, in addition calculate reverse index and do sanity check
-	fmt.Printf("var Chars = [%d]byte{", len(chars))
-	for i := 0; i < len(chars); i++ {
-		ch := chars[i]
-		lowerChars := bytes.ToLower([]byte{ch})
-		upperChars := bytes.ToUpper([]byte{ch})
-		if len(lowerChars) != 1 || len(upperChars) != 1 {
-			panic("lowercase & uppercase chars len should be 1")
-		}
-
-		lowerChar := lowerChars[0]
-		upperChar := upperChars[0]
+	if *out == "" {
+		panic("-out is required")
+	}
 
-		fmt.Printf("'%c',", lowerChar)
+	chars := []byte(*charsFlag)
+	if len(chars) != 32 {
+		panic(fmt.Sprintf("-chars must contain exactly 32 characters, got %d", len(chars)))
+	}
 
-		// ensure there is no duplicate char
-		_, contains := reverseCharIndices[ch]
-		if contains {
+	seen := make(map[byte]bool, len(chars))
+	for _, ch := range chars {
+		if seen[ch] {
 			panic(fmt.Sprintf("duplicate char %c", ch))
 		}
+		seen[ch] = true
+	}
 
-		reverseCharIndices[lowerChar], reverseCharIndices[upperChar] = i, i
+	var buf bytes.Buffer
 
-		if lowerChar > maxChar {
-			maxChar = lowerChar
+	// include standard code generator warning and package
+	fmt.Fprintln(&buf, "// Code generated by base32_table_gen. DO NOT EDIT.")
+	fmt.Fprintln(&buf, "package gen")
+	fmt.Fprintln(&buf)
+
+	// generate base32 index to chars
+	fmt.Fprintf(&buf, "var %sChars = [%d]byte{", *name, len(chars))
+	for _, ch := range chars {
+		fmt.Fprintf(&buf, "'%c',", ch)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	if *withCheck {
+		// generate check symbol table: the 32 data symbols followed by the 5
+		// extra Crockford check symbols, indexed by check value 0..36
+		fmt.Fprintf(&buf, "var %sCheckChars = [%d]byte{", *name, len(chars)+len(checkExtraChars))
+		for _, ch := range chars {
+			fmt.Fprintf(&buf, "'%c',", ch)
 		}
-		if upperChar > maxChar {
-			maxChar = lowerChar
+		for _, ch := range checkExtraChars {
+			fmt.Fprintf(&buf, "'%c',", ch)
 		}
+		fmt.Fprintln(&buf, "}")
 	}
-	fmt.Println("}")
 
-	// generate chars to index array
-	fmt.Printf("var CharToIndex = [%d]int{", maxChar + 1)
-	for i := byte(0); i <= maxChar; i++ {
-		ch, contains := reverseCharIndices[i]
-		charIndex := -1
-		if contains {
-			charIndex = int(ch)
-		}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
 
-		fmt.Printf("%d,", charIndex)
+	if err := ioutil.WriteFile(*out, formatted, 0644); err != nil {
+		panic(err)
 	}
-	fmt.Println("}")
 }
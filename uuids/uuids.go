@@ -0,0 +1,59 @@
+// Package uuids provides UUID-based ID encoding and decoding routines,
+// bridging ids.IDCodec to github.com/google/uuid
+package uuids
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gotruward/ids"
+)
+
+// uuidByteSize is the length, in bytes, of a UUID's binary representation
+const uuidByteSize = 16
+
+// ErrNotUUID happens when a decoded semantic ID does not carry exactly
+// uuidByteSize bytes, and therefore can't be a UUID
+var ErrNotUUID = errors.New("decoded semantic ID is not a UUID")
+
+// Decode is a helper method, that takes ID codec and encoded ID and returns
+// the UUID it represents
+func Decode(codec ids.IDCodec, id string) (uuid.UUID, error) {
+	raw, err := codec.Decode(id)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if len(raw) != uuidByteSize {
+		return uuid.UUID{}, ErrNotUUID
+	}
+
+	return uuid.FromBytes(raw)
+}
+
+// Encode is a helper method, that takes ID codec and UUID and returns
+// string-encoded representation of that UUID
+func Encode(codec ids.IDCodec, u uuid.UUID) (string, error) {
+	return codec.Encode(u[:])
+}
+
+// V4Codec combines a prefixed ids.IDCodec with UUIDv4 generation, so callers
+// can mint ready-to-use semantic IDs in one call
+type V4Codec struct {
+	ids.IDCodec
+}
+
+// NewV4Codec creates a V4Codec for a given sequence of names
+func NewV4Codec(names ...string) V4Codec {
+	return V4Codec{IDCodec: ids.NewCodecForNames(names...)}
+}
+
+// Mint generates a new random UUIDv4 and returns its semantic ID encoding
+func (c V4Codec) Mint() (string, error) {
+	u, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+
+	return Encode(c.IDCodec, u)
+}
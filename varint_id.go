@@ -0,0 +1,68 @@
+package ids
+
+import "encoding/binary"
+
+// NewVarintCodecForNames creates an IDCodec for a given sequence of names
+// that treats the encoded payload as a big-endian unsigned integer and
+// strips its leading zero bytes before encoding, so that Encode([]byte{0,
+// 0, 0, 1}) and Encode([]byte{1}) produce the same, shortest possible ID.
+// Decoding an ID produced by Encode returns the minimal-length byte slice,
+// never padded; Decode itself doesn't trim, so an ID made by another codec
+// keeps any leading zero bytes it carries.
+func NewVarintCodecForNames(names ...string) IDCodec {
+	return &varintIDCodec{IDCodec: NewCodecForNames(names...)}
+}
+
+// EncodeUint64 encodes v using codec, trimmed down to its minimal big-endian
+// byte representation
+func EncodeUint64(codec IDCodec, v uint64) (string, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return codec.Encode(trimLeadingZeros(buf[:]))
+}
+
+// DecodeUint64 decodes id using codec and interprets the result as a
+// big-endian unsigned integer
+func DecodeUint64(codec IDCodec, id string) (uint64, error) {
+	raw, err := codec.Decode(id)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(raw) > 8 {
+		return 0, ErrIDTooBig
+	}
+
+	var buf [8]byte
+	copy(buf[8-len(raw):], raw)
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+//
+// Implementation
+//
+
+type varintIDCodec struct {
+	IDCodec
+}
+
+// unwrap exposes the codec varintIDCodec wraps, so callers that need to
+// reach the underlying *prefixedIDCodec (e.g. the streaming encoder/decoder)
+// can see through the wrapper.
+func (c *varintIDCodec) unwrap() IDCodec {
+	return c.IDCodec
+}
+
+func (c *varintIDCodec) Encode(value []byte) (string, error) {
+	return c.IDCodec.Encode(trimLeadingZeros(value))
+}
+
+// trimLeadingZeros strips leading zero bytes from value, always leaving at
+// least one byte so that an all-zero value still encodes to something.
+func trimLeadingZeros(value []byte) []byte {
+	i := 0
+	for i < len(value)-1 && value[i] == 0 {
+		i++
+	}
+	return value[i:]
+}
@@ -0,0 +1,79 @@
+package ids_test
+
+import (
+	"testing"
+
+	"github.com/gotruward/ids"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVarintSemanticID(t *testing.T) {
+
+	t.Run("leading zero bytes don't affect the encoded ID", func(t *testing.T) {
+		idgen := ids.NewVarintCodecForNames("seq")
+
+		short, err := idgen.Encode([]byte{1})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		padded, err := idgen.Encode([]byte{0, 0, 0, 1})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, short, padded)
+
+		decoded, err := idgen.Decode(padded)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []byte{1}, decoded)
+		}
+	})
+
+	t.Run("all-zero value still encodes and decodes", func(t *testing.T) {
+		idgen := ids.NewVarintCodecForNames("seq")
+
+		id, err := idgen.Encode([]byte{0, 0, 0, 0})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		decoded, err := idgen.Decode(id)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []byte{0}, decoded)
+		}
+	})
+
+	t.Run("EncodeUint64 and DecodeUint64 round-trip", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("seq")
+
+		values := []uint64{0, 1, 255, 256, 1<<32 - 1, 1 << 63}
+		for _, v := range values {
+			id, err := ids.EncodeUint64(idgen, v)
+			if !assert.NoError(t, err, "encoding failed for v=%d", v) {
+				continue
+			}
+
+			decoded, err := ids.DecodeUint64(idgen, id)
+			if assert.NoError(t, err, "decoding failed for v=%d", v) {
+				assert.Equal(t, v, decoded, "round-trip mismatch for v=%d", v)
+			}
+		}
+	})
+
+	t.Run("small integers produce shorter IDs than large ones", func(t *testing.T) {
+		idgen := ids.NewCodecForNames("seq")
+
+		small, err := ids.EncodeUint64(idgen, 1)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		large, err := ids.EncodeUint64(idgen, 1<<63)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.True(t, len(small) < len(large))
+	})
+}